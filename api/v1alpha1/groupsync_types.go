@@ -0,0 +1,194 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/operator-framework/operator-lib/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PruneStrategyType controls what happens to OpenShift Groups this operator previously created
+// that no longer exist on the upstream provider.
+type PruneStrategyType string
+
+const (
+	// PruneStrategyDelete removes the OpenShift Group entirely
+	PruneStrategyDelete PruneStrategyType = "Delete"
+	// PruneStrategyEmpty keeps the Group but clears its Users
+	PruneStrategyEmpty PruneStrategyType = "Empty"
+	// PruneStrategyNone leaves stale Groups untouched. This is the default, preserving prior behavior.
+	PruneStrategyNone PruneStrategyType = "None"
+)
+
+// Provider defines a single upstream group source to sync Groups from
+type Provider struct {
+	// Name uniquely identifies this provider within the GroupSync instance
+	Name string `json:"name"`
+
+	// PruneStrategy controls how Groups that were previously created by this provider, but no
+	// longer exist upstream, are handled during a normal sync. Left unset, drift pruning defaults
+	// to None for backward compatibility, but deletion-time cleanup (see spec.deletionPolicy)
+	// still defaults to Delete unless this is explicitly set.
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Empty;None
+	PruneStrategy PruneStrategyType `json:"pruneStrategy,omitempty"`
+
+	// GroupNameTemplate, if set, is a Go template rendered against a TemplateData value to
+	// produce the name of the OpenShift Group instead of using the upstream group name verbatim,
+	// e.g. "{{ .Input.env }}-{{ .Group.Name }}"
+	// +optional
+	GroupNameTemplate string `json:"groupNameTemplate,omitempty"`
+
+	// LabelTemplates, if set, renders each value as a Go template against a TemplateData value
+	// and applies the result as a label on the produced Group, keyed by the same map key
+	// +optional
+	LabelTemplates map[string]string `json:"labelTemplates,omitempty"`
+
+	// DependsOn lists the names of other providers within this GroupSync that must complete
+	// successfully before this one is synced, e.g. an LDAP source before a Keycloak overlay that
+	// references it. Dependency cycles are rejected at validation time.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// KeyValue is a named string value, used to parameterize templates via spec.inputs
+type KeyValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CommonMetadata holds labels and annotations merged into every Group this GroupSync produces,
+// in addition to the SyncProvider and SyncTimestamp metadata the operator always sets
+type CommonMetadata struct {
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// DeletionPolicyType controls whether Groups created by a GroupSync are cleaned up when the
+// GroupSync itself is deleted
+type DeletionPolicyType string
+
+const (
+	// DeletionPolicyRetain leaves every managed Group in place when the GroupSync is deleted.
+	// This is the default, preserving prior behavior.
+	DeletionPolicyRetain DeletionPolicyType = "Retain"
+	// DeletionPolicyDelete removes (or empties, per each provider's PruneStrategy) every Group
+	// managed by the GroupSync when it is deleted
+	DeletionPolicyDelete DeletionPolicyType = "Delete"
+)
+
+// GroupSyncSpec defines the desired state of GroupSync
+type GroupSyncSpec struct {
+	// Providers is the list of upstream identity providers to sync Groups from
+	Providers []Provider `json:"providers"`
+
+	// Schedule, if set, is a cron expression controlling how often the sync is performed
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// DeletionPolicy controls whether Groups created by this GroupSync are cleaned up when the
+	// GroupSync itself is deleted. Defaults to Retain so existing deployments do not suddenly
+	// lose their Groups on CR removal.
+	// +optional
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +kubebuilder:default=Retain
+	DeletionPolicy DeletionPolicyType `json:"deletionPolicy,omitempty"`
+
+	// CommonMetadata holds labels and annotations merged into every Group produced by this
+	// GroupSync, regardless of provider
+	// +optional
+	CommonMetadata CommonMetadata `json:"commonMetadata,omitempty"`
+
+	// Inputs are named values that can be referenced from a provider's GroupNameTemplate or
+	// LabelTemplates as "{{ .Input.<name> }}", allowing a single operator instance to produce
+	// differently-named or -labeled Groups per environment without duplicating provider config
+	// +optional
+	Inputs []KeyValue `json:"inputs,omitempty"`
+
+	// Concurrency is the maximum number of providers synced at once. Defaults to the number of
+	// available CPUs.
+	// +optional
+	Concurrency int32 `json:"concurrency,omitempty"`
+}
+
+// ProviderStatus captures the outcome of the most recent sync for a single provider
+type ProviderStatus struct {
+	// Name is the provider this status applies to
+	Name string `json:"name"`
+
+	// LastSyncTime is when this provider was last attempted
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// GroupsUpdated is the number of Groups created or updated during the last sync
+	// +optional
+	GroupsUpdated int32 `json:"groupsUpdated,omitempty"`
+
+	// PrunedGroups is the number of Groups removed (or emptied) during the last sync because
+	// they no longer existed upstream
+	// +optional
+	PrunedGroups int32 `json:"prunedGroups,omitempty"`
+
+	// Error holds the last error encountered syncing this provider, if any
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// Skipped is true when this provider was not synced because one of its dependencies
+	// (spec.providers[].dependsOn) failed
+	// +optional
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+// GroupSyncStatus defines the observed state of GroupSync
+type GroupSyncStatus struct {
+	// +optional
+	Conditions status.Conditions `json:"conditions,omitempty"`
+
+	// +optional
+	LastSyncSuccessTime *metav1.Time `json:"lastSyncSuccessTime,omitempty"`
+
+	// ProviderStatuses reports the outcome of the last sync for each configured provider
+	// +optional
+	ProviderStatuses []ProviderStatus `json:"providerStatuses,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// GroupSync is the Schema for the groupsyncs API
+type GroupSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupSyncSpec   `json:"spec,omitempty"`
+	Status GroupSyncStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupSyncList contains a list of GroupSync
+type GroupSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupSync `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GroupSync{}, &GroupSyncList{})
+}
@@ -19,6 +19,10 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -26,16 +30,24 @@ import (
 	"github.com/operator-framework/operator-lib/status"
 	"github.com/prometheus/common/log"
 	"github.com/redhat-cop/group-sync-operator/pkg/constants"
+	"github.com/redhat-cop/group-sync-operator/pkg/metrics"
 	"github.com/redhat-cop/group-sync-operator/pkg/syncer"
 	"github.com/redhat-cop/operator-utils/pkg/util"
 	"github.com/robfig/cron"
+	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kubeclock "k8s.io/apimachinery/pkg/util/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	redhatcopv1alpha1 "github.com/redhat-cop/group-sync-operator/api/v1alpha1"
 )
@@ -71,6 +83,32 @@ func (r *GroupSyncReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, err
 	}
 
+	// Handle Deletion
+	if !instance.GetDeletionTimestamp().IsZero() {
+		if containsString(instance.GetFinalizers(), constants.GroupSyncFinalizer) {
+			if err := r.finalizeGroupSync(instance, logger); err != nil {
+				logger.Error(err, "Failed to Finalize GroupSync")
+				return ctrl.Result{}, err
+			}
+
+			instance.SetFinalizers(removeString(instance.GetFinalizers(), constants.GroupSyncFinalizer))
+			if err := r.GetClient().Update(context.TODO(), instance); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Register Finalizer
+	if !containsString(instance.GetFinalizers(), constants.GroupSyncFinalizer) {
+		instance.SetFinalizers(append(instance.GetFinalizers(), constants.GroupSyncFinalizer))
+		if err := r.GetClient().Update(context.TODO(), instance); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	// Get Group Sync Manager
 	groupSyncMgr, err := syncer.GetGroupSyncMgr(instance, r.ReconcilerBase)
 
@@ -93,108 +131,369 @@ func (r *GroupSyncReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return r.manageError(instance, err, logger)
 	}
 
-	// Execute Each Provider Syncer
+	// Build the dependency-ordered execution plan and run each batch of providers concurrently,
+	// bounded by spec.concurrency. A provider whose dependency errored or was itself skipped is
+	// marked Skipped rather than executed, and a provider failing no longer aborts its siblings.
+	// The reconcile as a whole only reports success once every provider has synced cleanly.
+	plan, err := syncer.BuildExecutionPlan(instance)
+	if err != nil {
+		return r.manageError(instance, err, logger)
+	}
+
+	groupSyncerByName := map[string]syncer.GroupSyncer{}
 	for _, groupSyncer := range groupSyncMgr.GroupSyncers {
+		groupSyncerByName[groupSyncer.GetProviderName()] = groupSyncer
+	}
 
-		logger.Info("Beginning Sync", "Provider", groupSyncer.GetProviderName())
+	inputMap := syncer.InputMap(instance.Spec.Inputs)
 
-		// Provider Label
-		providerLabel := fmt.Sprintf("%s_%s", instance.Name, groupSyncer.GetProviderName())
+	concurrency := int(instance.Spec.Concurrency)
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+
+	instance.Status.ProviderStatuses = nil
+	// unavailableProviders holds every provider that did not come out of this reconcile in a
+	// known-good state, whether because it errored directly or because it was itself skipped on
+	// account of an earlier dependency. A dependent checks membership in this combined set so a
+	// failure propagates through the whole dependsOn chain, not just to its direct dependents.
+	unavailableProviders := map[string]bool{}
+	erroredProviderNames := []string{}
+
+	for _, batch := range plan {
+		var g errgroup.Group
+		var mu sync.Mutex
+		batchStatuses := make(map[string]redhatcopv1alpha1.ProviderStatus, len(batch))
+
+		for _, providerName := range batch {
+			providerName := providerName
+			provider := findProvider(instance, providerName)
+
+			dependencyFailed := false
+			for _, dep := range provider.DependsOn {
+				if unavailableProviders[dep] {
+					dependencyFailed = true
+					break
+				}
+			}
 
-		// Initialize Connection
-		if err := groupSyncer.Bind(); err != nil {
-			return r.manageError(instance, err, logger)
+			if dependencyFailed {
+				logger.Info("Skipping Provider, Dependency Failed", "Provider", providerName)
+				mu.Lock()
+				batchStatuses[providerName] = redhatcopv1alpha1.ProviderStatus{Name: providerName, Skipped: true}
+				mu.Unlock()
+				continue
+			}
+
+			groupSyncer := groupSyncerByName[providerName]
+
+			g.Go(func() error {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				status := r.syncProvider(instance, groupSyncer, provider, inputMap, logger)
+
+				mu.Lock()
+				batchStatuses[providerName] = status
+				mu.Unlock()
+
+				return nil
+			})
 		}
 
-		// Perform Sync
-		groups, err := groupSyncer.Sync()
+		_ = g.Wait()
 
-		if err != nil {
-			logger.Error(err, "Failed to Complete Sync", "Provider", groupSyncer.GetProviderName())
-			return r.manageError(instance, err, logger)
+		for _, providerName := range batch {
+			providerStatus := batchStatuses[providerName]
+
+			if providerStatus.Error != "" {
+				unavailableProviders[providerName] = true
+				erroredProviderNames = append(erroredProviderNames, providerName)
+			} else if providerStatus.Skipped {
+				unavailableProviders[providerName] = true
+			}
+
+			instance.Status.ProviderStatuses = append(instance.Status.ProviderStatuses, providerStatus)
 		}
+	}
 
-		updatedGroups := 0
+	if len(erroredProviderNames) > 0 {
+		sort.Strings(erroredProviderNames)
+		return r.manageError(instance, fmt.Errorf("provider(s) failed to sync: %s", strings.Join(erroredProviderNames, ", ")), logger)
+	}
 
-		for _, group := range groups {
+	instance.Status.LastSyncSuccessTime = &metav1.Time{Time: clock.Now()}
+	metrics.LastSuccessTimestamp.WithLabelValues(instance.Name).Set(float64(instance.Status.LastSyncSuccessTime.Unix()))
 
-			ocpGroup := &userv1.Group{}
-			err := r.GetClient().Get(context.TODO(), types.NamespacedName{Name: group.Name, Namespace: ""}, ocpGroup)
+	successResult, err := r.manageSuccess(instance, logger)
 
-			if apierrors.IsNotFound(err) {
+	if err == nil && instance.Spec.Schedule != "" {
+		sched, _ := cron.ParseStandard(instance.Spec.Schedule)
 
-				ocpGroup = &userv1.Group{}
-				ocpGroup.Name = group.Name
+		currentTime := time.Now()
+		nextScheduledTime := sched.Next(currentTime)
+		successResult.RequeueAfter = nextScheduledTime.Sub(currentTime)
+	}
 
-			} else if err != nil {
-				return r.manageError(instance, err, logger)
-			} else {
-				// Verify this group is not managed by another provider
-				if groupProviderLabel, exists := ocpGroup.Labels[constants.SyncProvider]; !exists || (groupProviderLabel != providerLabel) {
-					log.Info("Group Provider Label Did Not Match Expected Provider Label", "Group Name", ocpGroup.Name, "Expected Label", providerLabel, "Found Label", groupProviderLabel)
-					continue
-				}
-			}
+	return successResult, err
+}
 
-			// Copy Annotations/Labels
-			ocpGroupLabels := map[string]string{}
-			ocpGroupAnnotations := map[string]string{}
+// syncProvider binds, syncs, applies, and prunes a single provider, returning a ProviderStatus
+// describing the outcome. Unlike the old sequential loop, an error here does not abort the
+// reconcile: it is recorded on the returned status so sibling providers (and, per dependsOn,
+// dependents) can be handled accordingly by the caller.
+func (r *GroupSyncReconciler) syncProvider(instance *redhatcopv1alpha1.GroupSync, groupSyncer syncer.GroupSyncer, provider *redhatcopv1alpha1.Provider, inputMap map[string]string, logger logr.Logger) redhatcopv1alpha1.ProviderStatus {
+	providerName := groupSyncer.GetProviderName()
+	logger = logger.WithValues("Provider", providerName)
+	providerStatus := redhatcopv1alpha1.ProviderStatus{Name: providerName, LastSyncTime: &metav1.Time{Time: clock.Now()}}
+
+	logger.Info("Beginning Sync")
+
+	// Provider Label
+	providerLabel := fmt.Sprintf("%s_%s", instance.Name, providerName)
+
+	// Initialize Connection
+	if err := groupSyncer.Bind(); err != nil {
+		metrics.SyncErrorsTotal.WithLabelValues(instance.Name, providerName, "Bind").Inc()
+		logger.Error(err, "Failed to Bind to Provider")
+		providerStatus.Error = err.Error()
+		return providerStatus
+	}
+
+	// Perform Sync
+	syncStart := time.Now()
+	groups, err := groupSyncer.Sync()
+	metrics.SyncDuration.WithLabelValues(instance.Name, providerName).Observe(time.Since(syncStart).Seconds())
+
+	if err != nil {
+		metrics.SyncErrorsTotal.WithLabelValues(instance.Name, providerName, "Sync").Inc()
+		logger.Error(err, "Failed to Complete Sync")
+		providerStatus.Error = err.Error()
+		return providerStatus
+	}
+
+	metrics.GroupsTotal.WithLabelValues(instance.Name, providerName).Set(float64(len(groups)))
 
-			if group.GetAnnotations() != nil {
-				ocpGroupAnnotations = group.GetAnnotations()
+	updatedGroups := 0
+	syncedGroupNames := map[string]bool{}
+
+	for _, group := range groups {
+
+		templateData := syncer.TemplateData{Group: group, Input: inputMap}
+
+		groupName := group.Name
+
+		if provider != nil && provider.GroupNameTemplate != "" {
+			renderedName, err := syncer.RenderTemplate(provider.GroupNameTemplate, templateData)
+			if err != nil {
+				logger.Error(err, "Failed to Render Group Name Template", "Group", group.Name)
+				providerStatus.Error = err.Error()
+				return providerStatus
 			}
+			groupName = renderedName
+		}
+
+		syncedGroupNames[groupName] = true
+
+		ocpGroup := &userv1.Group{}
+		err := r.GetClient().Get(context.TODO(), types.NamespacedName{Name: groupName, Namespace: ""}, ocpGroup)
 
-			if group.GetLabels() != nil {
-				ocpGroupLabels = group.GetLabels()
+		if apierrors.IsNotFound(err) {
+
+			ocpGroup = &userv1.Group{}
+			ocpGroup.Name = groupName
+
+		} else if err != nil {
+			providerStatus.Error = err.Error()
+			return providerStatus
+		} else {
+			// Verify this group is not managed by another provider
+			if groupProviderLabel, exists := ocpGroup.Labels[constants.SyncProvider]; !exists || (groupProviderLabel != providerLabel) {
+				log.Info("Group Provider Label Did Not Match Expected Provider Label", "Group Name", ocpGroup.Name, "Expected Label", providerLabel, "Found Label", groupProviderLabel)
+				metrics.GroupsSkippedTotal.WithLabelValues(instance.Name, providerName, "provider-label-mismatch").Inc()
+				continue
 			}
-			ocpGroup.SetLabels(ocpGroupLabels)
-			ocpGroup.SetAnnotations(ocpGroupAnnotations)
+		}
 
-			// Add Label for new resource
-			ocpGroup.Labels[constants.SyncProvider] = providerLabel
+		// Copy Common, then Group-specific, Annotations/Labels
+		ocpGroupLabels := map[string]string{}
+		ocpGroupAnnotations := map[string]string{}
 
-			// Add Gloabl Annotations/Labels
-			ocpGroup.Annotations[constants.SyncTimestamp] = ISO8601(time.Now())
+		for k, v := range instance.Spec.CommonMetadata.Labels {
+			ocpGroupLabels[k] = v
+		}
 
-			ocpGroup.Users = group.Users
+		for k, v := range instance.Spec.CommonMetadata.Annotations {
+			ocpGroupAnnotations[k] = v
+		}
 
-			err = r.CreateOrUpdateResource(instance, "", ocpGroup)
+		for k, v := range group.GetAnnotations() {
+			ocpGroupAnnotations[k] = v
+		}
 
-			if err != nil {
-				log.Error(err, "Failed to Create or Update OpenShift Group")
-				return r.manageError(instance, err, logger)
+		for k, v := range group.GetLabels() {
+			ocpGroupLabels[k] = v
+		}
+
+		if provider != nil {
+			for key, tmplText := range provider.LabelTemplates {
+				rendered, err := syncer.RenderTemplate(tmplText, templateData)
+				if err != nil {
+					logger.Error(err, "Failed to Render Label Template", "Label", key)
+					providerStatus.Error = err.Error()
+					return providerStatus
+				}
+				ocpGroupLabels[key] = rendered
 			}
+		}
+
+		ocpGroup.SetLabels(ocpGroupLabels)
+		ocpGroup.SetAnnotations(ocpGroupAnnotations)
+
+		// Add Label for new resource
+		ocpGroup.Labels[constants.SyncProvider] = providerLabel
+
+		// Add Gloabl Annotations/Labels
+		ocpGroup.Annotations[constants.SyncTimestamp] = ISO8601(time.Now())
 
-			updatedGroups++
+		ocpGroup.Users = group.Users
 
+		err = r.CreateOrUpdateResource(instance, "", ocpGroup)
+
+		if err != nil {
+			log.Error(err, "Failed to Create or Update OpenShift Group")
+			metrics.SyncErrorsTotal.WithLabelValues(instance.Name, providerName, "Apply").Inc()
+			providerStatus.Error = err.Error()
+			return providerStatus
 		}
 
-		logger.Info("Sync Completed Successfully", "Provider", groupSyncer.GetProviderName(), "Groups Created or Updated", updatedGroups)
+		metrics.GroupsUpdatedTotal.WithLabelValues(instance.Name, providerName).Inc()
+		updatedGroups++
 
 	}
 
-	instance.Status.LastSyncSuccessTime = &metav1.Time{Time: clock.Now()}
+	logger.Info("Sync Completed Successfully", "Groups Created or Updated", updatedGroups)
+	providerStatus.GroupsUpdated = int32(updatedGroups)
 
-	successResult, err := r.manageSuccess(instance, logger)
+	if provider != nil {
+		prunedGroups, err := r.pruneStaleGroups(instance, provider, providerLabel, syncedGroupNames)
 
-	if err == nil && instance.Spec.Schedule != "" {
-		sched, _ := cron.ParseStandard(instance.Spec.Schedule)
+		if err != nil {
+			logger.Error(err, "Failed to Prune Stale Groups")
+			providerStatus.Error = err.Error()
+			return providerStatus
+		}
 
-		currentTime := time.Now()
-		nextScheduledTime := sched.Next(currentTime)
-		successResult.RequeueAfter = nextScheduledTime.Sub(currentTime)
+		if prunedGroups > 0 {
+			logger.Info("Pruned Stale Groups", "Groups Pruned", prunedGroups)
+		}
+
+		providerStatus.PrunedGroups = prunedGroups
 	}
 
-	return successResult, err
+	return providerStatus
 }
 
 func (r *GroupSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redhatcopv1alpha1.GroupSync{}).
 		WithEventFilter(util.ResourceGenerationOrFinalizerChangedPredicate{}).
+		Watches(
+			&source.Kind{Type: &userv1.Group{}},
+			&handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(r.managedGroupRequests)},
+			builder.WithPredicates(managedGroupPredicate),
+		).
 		Complete(r)
 }
 
+// managedGroupRequests maps a userv1.Group carrying a constants.SyncProvider label of the form
+// "{groupsync-name}_{provider}" back to a reconcile.Request for the owning GroupSync, so external
+// edits (or deletions) of a managed Group are corrected without waiting for the next scheduled
+// sync.
+func (r *GroupSyncReconciler) managedGroupRequests(a handler.MapObject) []reconcile.Request {
+	providerLabel, exists := a.Meta.GetLabels()[constants.SyncProvider]
+	if !exists {
+		return nil
+	}
+
+	groupSyncName := groupSyncNameFromProviderLabel(providerLabel)
+	if groupSyncName == "" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: groupSyncName}},
+	}
+}
+
+func groupSyncNameFromProviderLabel(providerLabel string) string {
+	// Split on the first "_": instance.Name is a Kubernetes object name and cannot contain one,
+	// but provider names are free-form and may (see finalizeGroupSync, which matches the same
+	// "{groupsync-name}_{provider}" convention by prefix rather than by last separator).
+	idx := strings.Index(providerLabel, "_")
+	if idx <= 0 || idx == len(providerLabel)-1 {
+		return ""
+	}
+
+	return providerLabel[:idx]
+}
+
+// managedGroupPredicate only lets through events for Groups this operator manages, and drops
+// Update events that don't change anything a sync would care about (e.g. a status-only update).
+var managedGroupPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return hasSyncProviderLabel(e.Meta)
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return hasSyncProviderLabel(e.Meta)
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return hasSyncProviderLabel(e.Meta)
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if !hasSyncProviderLabel(e.MetaNew) {
+			return false
+		}
+
+		oldGroup, oldOk := e.ObjectOld.(*userv1.Group)
+		newGroup, newOk := e.ObjectNew.(*userv1.Group)
+
+		if !oldOk || !newOk {
+			return true
+		}
+
+		return !reflect.DeepEqual(oldGroup.Users, newGroup.Users) ||
+			!reflect.DeepEqual(oldGroup.Labels, newGroup.Labels) ||
+			!reflect.DeepEqual(annotationsWithoutBookkeeping(oldGroup.Annotations), annotationsWithoutBookkeeping(newGroup.Annotations))
+	},
+}
+
+func hasSyncProviderLabel(meta metav1.Object) bool {
+	_, exists := meta.GetLabels()[constants.SyncProvider]
+	return exists
+}
+
+// annotationsWithoutBookkeeping strips constants.SyncTimestamp, which syncProvider rewrites on
+// every single sync pass regardless of whether anything else about the Group changed. Comparing
+// it as-is would make managedGroupPredicate re-enqueue a reconcile after every sync purely
+// because of the timestamp it just wrote, hot-looping instead of resyncing only on real drift.
+func annotationsWithoutBookkeeping(annotations map[string]string) map[string]string {
+	if _, ok := annotations[constants.SyncTimestamp]; !ok {
+		return annotations
+	}
+
+	stripped := make(map[string]string, len(annotations)-1)
+	for k, v := range annotations {
+		if k == constants.SyncTimestamp {
+			continue
+		}
+		stripped[k] = v
+	}
+
+	return stripped
+}
+
 func (r *GroupSyncReconciler) manageSuccess(instance *redhatcopv1alpha1.GroupSync, logger logr.Logger) (ctrl.Result, error) {
 	condition := status.Condition{
 		Type:    status.ConditionType("groupsync"),
@@ -235,6 +534,151 @@ func (r *GroupSyncReconciler) manageError(instance *redhatcopv1alpha1.GroupSync,
 	return reconcile.Result{}, err
 }
 
+// findProvider returns the spec for the provider with the given name, or nil if it has no
+// corresponding entry (which should not normally happen, since syncers are built from the spec)
+func findProvider(instance *redhatcopv1alpha1.GroupSync, providerName string) *redhatcopv1alpha1.Provider {
+	for i := range instance.Spec.Providers {
+		if instance.Spec.Providers[i].Name == providerName {
+			return &instance.Spec.Providers[i]
+		}
+	}
+
+	return nil
+}
+
+// pruneStaleGroups reconciles Groups labeled for this provider against the set of names returned
+// by the most recent Sync, deleting or emptying whatever is left over according to the provider's
+// PruneStrategy. It returns the number of Groups affected.
+func (r *GroupSyncReconciler) pruneStaleGroups(instance *redhatcopv1alpha1.GroupSync, provider *redhatcopv1alpha1.Provider, providerLabel string, syncedGroupNames map[string]bool) (int32, error) {
+	if provider.PruneStrategy == redhatcopv1alpha1.PruneStrategyNone || provider.PruneStrategy == "" {
+		return 0, nil
+	}
+
+	groupList := &userv1.GroupList{}
+
+	if err := r.GetClient().List(context.TODO(), groupList, client.MatchingLabels{constants.SyncProvider: providerLabel}); err != nil {
+		return 0, err
+	}
+
+	var pruned int32
+
+	for i := range groupList.Items {
+		staleGroup := &groupList.Items[i]
+
+		if syncedGroupNames[staleGroup.Name] {
+			continue
+		}
+
+		switch provider.PruneStrategy {
+		case redhatcopv1alpha1.PruneStrategyDelete:
+			if err := r.GetClient().Delete(context.TODO(), staleGroup); err != nil && !apierrors.IsNotFound(err) {
+				return pruned, err
+			}
+			r.GetRecorder().Eventf(instance, corev1.EventTypeNormal, "GroupPruned", "Deleted Group '%s', no longer returned by provider '%s'", staleGroup.Name, provider.Name)
+
+		case redhatcopv1alpha1.PruneStrategyEmpty:
+			if len(staleGroup.Users) == 0 {
+				continue
+			}
+
+			staleGroup.Users = []string{}
+
+			if err := r.GetClient().Update(context.TODO(), staleGroup); err != nil {
+				return pruned, err
+			}
+			r.GetRecorder().Eventf(instance, corev1.EventTypeNormal, "GroupPruned", "Emptied Group '%s', no longer returned by provider '%s'", staleGroup.Name, provider.Name)
+
+		default:
+			continue
+		}
+
+		pruned++
+	}
+
+	return pruned, nil
+}
+
+// finalizeGroupSync removes (or empties, per each owning provider's PruneStrategy) every Group
+// managed by instance, provided its DeletionPolicy opts in to cleanup. It is safe to call
+// repeatedly: Groups already removed simply stop showing up in the list on the next attempt.
+func (r *GroupSyncReconciler) finalizeGroupSync(instance *redhatcopv1alpha1.GroupSync, logger logr.Logger) error {
+	if instance.Spec.DeletionPolicy != redhatcopv1alpha1.DeletionPolicyDelete {
+		return nil
+	}
+
+	groupList := &userv1.GroupList{}
+
+	if err := r.GetClient().List(context.TODO(), groupList); err != nil {
+		return err
+	}
+
+	ownerPrefix := instance.Name + "_"
+
+	for i := range groupList.Items {
+		managedGroup := &groupList.Items[i]
+
+		providerLabel, exists := managedGroup.Labels[constants.SyncProvider]
+		if !exists || !strings.HasPrefix(providerLabel, ownerPrefix) {
+			continue
+		}
+
+		providerName := strings.TrimPrefix(providerLabel, ownerPrefix)
+		pruneStrategy := redhatcopv1alpha1.PruneStrategyDelete
+
+		if provider := findProvider(instance, providerName); provider != nil && provider.PruneStrategy != "" {
+			pruneStrategy = provider.PruneStrategy
+		}
+
+		switch pruneStrategy {
+		case redhatcopv1alpha1.PruneStrategyNone:
+			continue
+
+		case redhatcopv1alpha1.PruneStrategyEmpty:
+			if len(managedGroup.Users) == 0 {
+				continue
+			}
+
+			managedGroup.Users = []string{}
+
+			if err := r.GetClient().Update(context.TODO(), managedGroup); err != nil {
+				return err
+			}
+
+		default:
+			if err := r.GetClient().Delete(context.TODO(), managedGroup); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+
+		logger.Info("Cleaned Up Managed Group", "Group Name", managedGroup.Name, "Provider", providerName)
+		r.GetRecorder().Eventf(instance, corev1.EventTypeNormal, "GroupSyncTerminated", "Cleaned up Group '%s' owned by provider '%s'", managedGroup.Name, providerName)
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeString(values []string, value string) []string {
+	result := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
 func ISO8601(t time.Time) string {
 	var tz string
 	if zone, offset := t.Zone(); zone == "UTC" {
@@ -0,0 +1,31 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package constants holds well-known label and annotation keys shared across the operator
+package constants
+
+const (
+	// SyncProvider is the label applied to every OpenShift Group created by this operator,
+	// recording the "{groupsync-name}_{provider}" that owns it
+	SyncProvider = "sync.openshift.io/provider"
+
+	// SyncTimestamp is the annotation recording the last time a Group was synced
+	SyncTimestamp = "sync.openshift.io/timestamp"
+
+	// GroupSyncFinalizer is registered on every GroupSync so its managed Groups can be cleaned
+	// up before the GroupSync is removed
+	GroupSyncFinalizer = "redhatcop.redhat.io/groupsync-cleanup"
+)
@@ -0,0 +1,63 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// TestMetricsObserveWithExpectedLabels guards the label sets each metric is declared with: a
+// mismatch here is exactly the kind of typo (e.g. a renamed "provider" label) that would
+// otherwise only surface as a panic deep inside a reconcile, the first time that code path runs.
+func TestMetricsObserveWithExpectedLabels(t *testing.T) {
+	SyncDuration.WithLabelValues("my-groupsync", "alpha").Observe(1.5)
+	GroupsTotal.WithLabelValues("my-groupsync", "alpha").Set(3)
+	GroupsUpdatedTotal.WithLabelValues("my-groupsync", "alpha").Inc()
+	GroupsSkippedTotal.WithLabelValues("my-groupsync", "alpha", "provider-label-mismatch").Inc()
+	SyncErrorsTotal.WithLabelValues("my-groupsync", "alpha", "Bind").Inc()
+	LastSuccessTimestamp.WithLabelValues("my-groupsync").Set(1234)
+}
+
+// TestMetricsAreRegistered confirms init() wired every declared metric into the controller-runtime
+// registry, so none of them were silently dropped from Prometheus scrapes. Registering an
+// already-registered collector again fails with an AlreadyRegisteredError naming the same
+// collector, which is exactly what we expect to find there.
+func TestMetricsAreRegistered(t *testing.T) {
+	collectors := []prometheus.Collector{
+		SyncDuration,
+		GroupsTotal,
+		GroupsUpdatedTotal,
+		GroupsSkippedTotal,
+		SyncErrorsTotal,
+		LastSuccessTimestamp,
+	}
+
+	for _, c := range collectors {
+		err := ctrlmetrics.Registry.Register(c)
+
+		are, ok := err.(prometheus.AlreadyRegisteredError)
+		if !ok {
+			t.Fatalf("expected collector to already be registered by init(), got err=%v", err)
+		}
+		if are.ExistingCollector != c {
+			t.Fatalf("expected the already-registered collector to be this package's instance")
+		}
+	}
+}
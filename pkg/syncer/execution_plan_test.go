@@ -0,0 +1,97 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"reflect"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/group-sync-operator/api/v1alpha1"
+)
+
+func TestBuildExecutionPlanOrdersByDependsOn(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Providers: []redhatcopv1alpha1.Provider{
+				{Name: "c", DependsOn: []string{"b"}},
+				{Name: "a"},
+				{Name: "b", DependsOn: []string{"a"}},
+			},
+		},
+	}
+
+	plan, err := BuildExecutionPlan(instance)
+	if err != nil {
+		t.Fatalf("BuildExecutionPlan: %v", err)
+	}
+
+	want := ExecutionPlan{{"a"}, {"b"}, {"c"}}
+	if !reflect.DeepEqual(plan, want) {
+		t.Fatalf("expected %v, got %v", want, plan)
+	}
+}
+
+func TestBuildExecutionPlanBatchesIndependentProviders(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Providers: []redhatcopv1alpha1.Provider{
+				{Name: "alpha"},
+				{Name: "bravo"},
+				{Name: "charlie", DependsOn: []string{"alpha", "bravo"}},
+			},
+		},
+	}
+
+	plan, err := BuildExecutionPlan(instance)
+	if err != nil {
+		t.Fatalf("BuildExecutionPlan: %v", err)
+	}
+
+	want := ExecutionPlan{{"alpha", "bravo"}, {"charlie"}}
+	if !reflect.DeepEqual(plan, want) {
+		t.Fatalf("expected %v, got %v", want, plan)
+	}
+}
+
+func TestBuildExecutionPlanRejectsUnknownDependency(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Providers: []redhatcopv1alpha1.Provider{
+				{Name: "alpha", DependsOn: []string{"ghost"}},
+			},
+		},
+	}
+
+	if _, err := BuildExecutionPlan(instance); err == nil {
+		t.Fatalf("expected an error for a dependency on an unknown provider")
+	}
+}
+
+func TestBuildExecutionPlanRejectsCycle(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Providers: []redhatcopv1alpha1.Provider{
+				{Name: "alpha", DependsOn: []string{"bravo"}},
+				{Name: "bravo", DependsOn: []string{"alpha"}},
+			},
+		},
+	}
+
+	if _, err := BuildExecutionPlan(instance); err == nil {
+		t.Fatalf("expected an error for a dependency cycle")
+	}
+}
@@ -0,0 +1,203 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package syncer implements the per-provider synchronization logic invoked by
+// the GroupSync controller.
+package syncer
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+
+	userv1 "github.com/openshift/api/user/v1"
+	redhatcopv1alpha1 "github.com/redhat-cop/group-sync-operator/api/v1alpha1"
+	"github.com/redhat-cop/operator-utils/pkg/util"
+)
+
+// GroupSyncer is implemented by each upstream identity provider integration (LDAP, GitHub,
+// Keycloak, ...). Sync returns the desired state of every Group known to that provider.
+type GroupSyncer interface {
+	Bind() error
+	Sync() ([]userv1.Group, error)
+	GetProviderName() string
+}
+
+// GroupSyncManager coordinates the syncers configured for a single GroupSync instance
+type GroupSyncManager struct {
+	Instance       *redhatcopv1alpha1.GroupSync
+	ReconcilerBase util.ReconcilerBase
+	GroupSyncers   []GroupSyncer
+}
+
+// GetGroupSyncMgr builds a GroupSyncManager with one GroupSyncer per configured provider
+func GetGroupSyncMgr(instance *redhatcopv1alpha1.GroupSync, reconcilerBase util.ReconcilerBase) (*GroupSyncManager, error) {
+	groupSyncMgr := &GroupSyncManager{
+		Instance:       instance,
+		ReconcilerBase: reconcilerBase,
+	}
+
+	for i := range instance.Spec.Providers {
+		provider := &instance.Spec.Providers[i]
+
+		groupSyncer, err := newProviderSyncer(provider, reconcilerBase)
+		if err != nil {
+			return nil, err
+		}
+
+		groupSyncMgr.GroupSyncers = append(groupSyncMgr.GroupSyncers, groupSyncer)
+	}
+
+	return groupSyncMgr, nil
+}
+
+// SetDefaults applies default values to the GroupSync instance, returning true if any field
+// was changed
+//
+// Note that provider.PruneStrategy is deliberately left unset ("") here rather than persisted as
+// PruneStrategyNone: an empty PruneStrategy means "use the default for whatever is being decided
+// right now", and that default differs between drift-time pruning (None, for backward
+// compatibility - see pruneStaleGroups) and deletion-time cleanup (Delete, so spec.deletionPolicy:
+// Delete actually deletes by default - see finalizeGroupSync). Persisting None here would make
+// every provider look like it had explicitly opted out of deletion-time cleanup after its first
+// reconcile.
+func (g *GroupSyncManager) SetDefaults() bool {
+	changed := false
+
+	if g.Instance.Spec.DeletionPolicy == "" {
+		g.Instance.Spec.DeletionPolicy = redhatcopv1alpha1.DeletionPolicyRetain
+		changed = true
+	}
+
+	if g.Instance.Spec.Concurrency == 0 {
+		g.Instance.Spec.Concurrency = int32(runtime.NumCPU())
+		changed = true
+	}
+
+	return changed
+}
+
+// Validate checks that the GroupSync instance is internally consistent before any provider is synced
+func (g *GroupSyncManager) Validate() error {
+	switch g.Instance.Spec.DeletionPolicy {
+	case redhatcopv1alpha1.DeletionPolicyRetain, redhatcopv1alpha1.DeletionPolicyDelete, "":
+	default:
+		return fmt.Errorf("invalid deletionPolicy '%s'", g.Instance.Spec.DeletionPolicy)
+	}
+
+	if err := validateTemplates(g.Instance); err != nil {
+		return err
+	}
+
+	if _, err := BuildExecutionPlan(g.Instance); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+
+	for _, provider := range g.Instance.Spec.Providers {
+		if provider.Name == "" {
+			return fmt.Errorf("provider name must not be empty")
+		}
+
+		if seen[provider.Name] {
+			return fmt.Errorf("duplicate provider name '%s'", provider.Name)
+		}
+		seen[provider.Name] = true
+
+		switch provider.PruneStrategy {
+		case redhatcopv1alpha1.PruneStrategyDelete, redhatcopv1alpha1.PruneStrategyEmpty, redhatcopv1alpha1.PruneStrategyNone, "":
+		default:
+			return fmt.Errorf("provider '%s' has an invalid pruneStrategy '%s'", provider.Name, provider.PruneStrategy)
+		}
+	}
+
+	return nil
+}
+
+func newProviderSyncer(provider *redhatcopv1alpha1.Provider, reconcilerBase util.ReconcilerBase) (GroupSyncer, error) {
+	return nil, fmt.Errorf("no syncer implementation registered for provider '%s'", provider.Name)
+}
+
+// ExecutionPlan is a sequence of batches of provider names: every provider in a batch may run
+// concurrently, but only once every provider in every earlier batch has completed
+type ExecutionPlan [][]string
+
+// BuildExecutionPlan topologically sorts instance.Spec.Providers by DependsOn into batches
+// suitable for concurrent dispatch, returning an error if a dependency is unknown or a cycle
+// is found
+func BuildExecutionPlan(instance *redhatcopv1alpha1.GroupSync) (ExecutionPlan, error) {
+	providers := instance.Spec.Providers
+
+	inDegree := make(map[string]int, len(providers))
+	dependents := map[string][]string{}
+
+	for _, provider := range providers {
+		if _, ok := inDegree[provider.Name]; !ok {
+			inDegree[provider.Name] = 0
+		}
+
+		for _, dep := range provider.DependsOn {
+			if !hasProvider(providers, dep) {
+				return nil, fmt.Errorf("provider '%s' depends on unknown provider '%s'", provider.Name, dep)
+			}
+
+			inDegree[provider.Name]++
+			dependents[dep] = append(dependents[dep], provider.Name)
+		}
+	}
+
+	var plan ExecutionPlan
+	remaining := len(inDegree)
+
+	for remaining > 0 {
+		var batch []string
+
+		for name, degree := range inDegree {
+			if degree == 0 {
+				batch = append(batch, name)
+			}
+		}
+
+		if len(batch) == 0 {
+			return nil, fmt.Errorf("dependency cycle detected among providers")
+		}
+
+		sort.Strings(batch)
+		plan = append(plan, batch)
+
+		for _, name := range batch {
+			delete(inDegree, name)
+			remaining--
+
+			for _, dependent := range dependents[name] {
+				inDegree[dependent]--
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+func hasProvider(providers []redhatcopv1alpha1.Provider, name string) bool {
+	for _, provider := range providers {
+		if provider.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,112 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/group-sync-operator/api/v1alpha1"
+)
+
+func TestSetDefaultsFillsDeletionPolicyAndConcurrency(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Providers: []redhatcopv1alpha1.Provider{{Name: "alpha"}},
+		},
+	}
+
+	mgr := &GroupSyncManager{Instance: instance}
+
+	if changed := mgr.SetDefaults(); !changed {
+		t.Fatalf("expected SetDefaults to report a change on first call")
+	}
+
+	if got := instance.Spec.DeletionPolicy; got != redhatcopv1alpha1.DeletionPolicyRetain {
+		t.Fatalf("expected DeletionPolicy to default to %q, got %q", redhatcopv1alpha1.DeletionPolicyRetain, got)
+	}
+
+	if instance.Spec.Concurrency == 0 {
+		t.Fatalf("expected Concurrency to be defaulted to a positive value")
+	}
+
+	if changed := mgr.SetDefaults(); changed {
+		t.Fatalf("expected SetDefaults to be a no-op once defaults are already set")
+	}
+}
+
+// TestSetDefaultsLeavesPruneStrategyUnset guards against regressing the deletion-time cleanup
+// bug: persisting a default PruneStrategy here would make finalizeGroupSync see an explicit
+// "None" on every provider after the first reconcile and skip deletion-time cleanup entirely.
+func TestSetDefaultsLeavesPruneStrategyUnset(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Providers: []redhatcopv1alpha1.Provider{{Name: "alpha"}},
+		},
+	}
+
+	mgr := &GroupSyncManager{Instance: instance}
+	mgr.SetDefaults()
+
+	if got := instance.Spec.Providers[0].PruneStrategy; got != "" {
+		t.Fatalf("expected PruneStrategy to remain unset, got %q", got)
+	}
+}
+
+func TestValidateRejectsBadProviders(t *testing.T) {
+	cases := []struct {
+		name      string
+		providers []redhatcopv1alpha1.Provider
+		wantErr   bool
+	}{
+		{
+			name:      "empty provider name",
+			providers: []redhatcopv1alpha1.Provider{{Name: ""}},
+			wantErr:   true,
+		},
+		{
+			name:      "duplicate provider name",
+			providers: []redhatcopv1alpha1.Provider{{Name: "alpha"}, {Name: "alpha"}},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid pruneStrategy",
+			providers: []redhatcopv1alpha1.Provider{{Name: "alpha", PruneStrategy: "Bogus"}},
+			wantErr:   true,
+		},
+		{
+			name:      "valid single provider",
+			providers: []redhatcopv1alpha1.Provider{{Name: "alpha", PruneStrategy: redhatcopv1alpha1.PruneStrategyDelete}},
+			wantErr:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mgr := &GroupSyncManager{Instance: &redhatcopv1alpha1.GroupSync{
+				Spec: redhatcopv1alpha1.GroupSyncSpec{Providers: tc.providers},
+			}}
+
+			err := mgr.Validate()
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected an error, got none")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
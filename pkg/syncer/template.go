@@ -0,0 +1,96 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"text/template"
+
+	userv1 "github.com/openshift/api/user/v1"
+	redhatcopv1alpha1 "github.com/redhat-cop/group-sync-operator/api/v1alpha1"
+)
+
+// TemplateData is the context a provider's GroupNameTemplate and LabelTemplates are rendered
+// against
+type TemplateData struct {
+	// Group is the raw Group as returned by the provider's Sync call, before any template is applied
+	Group userv1.Group
+	// Input holds the GroupSync's spec.inputs, keyed by name
+	Input map[string]string
+}
+
+// InputMap converts spec.inputs into the map[string]string a TemplateData expects
+func InputMap(inputs []redhatcopv1alpha1.KeyValue) map[string]string {
+	inputMap := make(map[string]string, len(inputs))
+	for _, input := range inputs {
+		inputMap[input.Name] = input.Value
+	}
+
+	return inputMap
+}
+
+// RenderTemplate parses and executes tmplText against data
+func RenderTemplate(tmplText string, data TemplateData) (string, error) {
+	tmpl, err := template.New("groupsync").Parse(tmplText)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// validateTemplates parses and test-executes every GroupNameTemplate and LabelTemplates entry
+// configured on the instance, so a malformed template fails Validate() instead of surfacing
+// mid-sync as an apply error
+func validateTemplates(instance *redhatcopv1alpha1.GroupSync) error {
+	sampleData := TemplateData{
+		Group: userv1.Group{},
+		Input: InputMap(instance.Spec.Inputs),
+	}
+
+	for _, provider := range instance.Spec.Providers {
+		if provider.GroupNameTemplate != "" {
+			if err := checkTemplate(provider.GroupNameTemplate, sampleData); err != nil {
+				return fmt.Errorf("provider '%s' has an invalid groupNameTemplate: %w", provider.Name, err)
+			}
+		}
+
+		for key, tmplText := range provider.LabelTemplates {
+			if err := checkTemplate(tmplText, sampleData); err != nil {
+				return fmt.Errorf("provider '%s' has an invalid labelTemplate for key '%s': %w", provider.Name, key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkTemplate(tmplText string, sampleData TemplateData) error {
+	tmpl, err := template.New("groupsync").Parse(tmplText)
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(ioutil.Discard, sampleData)
+}
@@ -0,0 +1,105 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syncer
+
+import (
+	"testing"
+
+	userv1 "github.com/openshift/api/user/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/group-sync-operator/api/v1alpha1"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	data := TemplateData{
+		Group: userv1.Group{ObjectMeta: metav1.ObjectMeta{Name: "engineering"}},
+		Input: map[string]string{"env": "prod"},
+	}
+
+	got, err := RenderTemplate("{{ .Input.env }}-{{ .Group.Name }}", data)
+	if err != nil {
+		t.Fatalf("RenderTemplate: %v", err)
+	}
+
+	if want := "prod-engineering"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestRenderTemplateInvalidSyntax(t *testing.T) {
+	_, err := RenderTemplate("{{ .Input.env", TemplateData{})
+	if err == nil {
+		t.Fatalf("expected an error for malformed template syntax")
+	}
+}
+
+func TestInputMap(t *testing.T) {
+	got := InputMap([]redhatcopv1alpha1.KeyValue{{Name: "env", Value: "prod"}, {Name: "region", Value: "us-east"}})
+
+	if got["env"] != "prod" || got["region"] != "us-east" || len(got) != 2 {
+		t.Fatalf("unexpected InputMap result: %#v", got)
+	}
+}
+
+func TestValidateTemplatesRejectsMalformedGroupNameTemplate(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Providers: []redhatcopv1alpha1.Provider{
+				{Name: "alpha", GroupNameTemplate: "{{ .Group.Name "},
+			},
+		},
+	}
+
+	if err := validateTemplates(instance); err == nil {
+		t.Fatalf("expected an error for a malformed groupNameTemplate")
+	}
+}
+
+func TestValidateTemplatesRejectsMalformedLabelTemplate(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Inputs: []redhatcopv1alpha1.KeyValue{{Name: "bogus", Value: "x"}},
+			Providers: []redhatcopv1alpha1.Provider{
+				{Name: "alpha", LabelTemplates: map[string]string{"team": "{{ .Input.bogus.deeper }}"}},
+			},
+		},
+	}
+
+	if err := validateTemplates(instance); err == nil {
+		t.Fatalf("expected an error for a label template referencing an invalid field")
+	}
+}
+
+func TestValidateTemplatesAcceptsWellFormedTemplates(t *testing.T) {
+	instance := &redhatcopv1alpha1.GroupSync{
+		Spec: redhatcopv1alpha1.GroupSyncSpec{
+			Inputs: []redhatcopv1alpha1.KeyValue{{Name: "env", Value: "prod"}},
+			Providers: []redhatcopv1alpha1.Provider{
+				{
+					Name:              "alpha",
+					GroupNameTemplate: "{{ .Input.env }}-{{ .Group.Name }}",
+					LabelTemplates:    map[string]string{"team": "{{ .Group.Name }}"},
+				},
+			},
+		},
+	}
+
+	if err := validateTemplates(instance); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}